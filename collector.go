@@ -0,0 +1,155 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"log"
+	"net/http"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+
+	"github.com/MicahParks/temperature-challenge/locations"
+	"github.com/MicahParks/temperature-challenge/providers"
+)
+
+const (
+
+	// metricsAddrEnv overrides the address the /metrics server listens on.
+	metricsAddrEnv = "METRICS_ADDR"
+
+	// defaultMetricsAddr is used when metricsAddrEnv isn't set.
+	defaultMetricsAddr = ":9100"
+
+	// fetchIntervalEnv overrides how often the collector re-fetches every city's temperature.
+	fetchIntervalEnv = "FETCH_INTERVAL"
+
+	// defaultFetchInterval is used when fetchIntervalEnv isn't set, matching the telegraf openweathermap input
+	// plugin's default polling interval.
+	defaultFetchInterval = 10 * time.Minute
+
+	// shutdownTimeout bounds how long the /metrics server is given to drain in-flight scrapes on shutdown.
+	shutdownTimeout = 5 * time.Second
+)
+
+// runCollector serves Prometheus metrics on /metrics and, in the background, periodically re-fetches every city's
+// temperature to keep those metrics fresh. It blocks until ctx is canceled, then shuts the server down gracefully.
+func runCollector(ctx context.Context, logger *log.Logger, provider providers.WeatherProvider, providerName string, coords []locations.Location) error {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.Handler())
+
+	addr := metricsAddr()
+	server := &http.Server{Addr: addr, Handler: mux}
+
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer cancel()
+		server.Shutdown(shutdownCtx) // Ignore this error, if any; we're exiting regardless.
+	}()
+
+	go collectLoop(ctx, logger, provider, providerName, coords)
+
+	logger.Printf("Serving Prometheus metrics on %s/metrics every %s.", addr, fetchInterval())
+	if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+
+	return nil
+}
+
+// collectLoop fetches every city's temperature once immediately, then again on every tick of fetchInterval, until
+// ctx is canceled.
+func collectLoop(ctx context.Context, logger *log.Logger, provider providers.WeatherProvider, providerName string, coords []locations.Location) {
+	collectOnce(ctx, logger, provider, providerName, coords)
+
+	ticker := time.NewTicker(fetchInterval())
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			collectOnce(ctx, logger, provider, providerName, coords)
+		}
+	}
+}
+
+// collectOnce fetches every city's temperature and updates the Prometheus gauges and counters declared in
+// metrics.go.
+func collectOnce(ctx context.Context, logger *log.Logger, provider providers.WeatherProvider, providerName string, coords []locations.Location) {
+	ctx, cancel := context.WithTimeout(ctx, globalDeadline)
+	defer cancel()
+
+	start := time.Now()
+	results, err := fetchAll(ctx, provider, coords, concurrency())
+	fetchDurationSeconds.WithLabelValues(providerName).Observe(time.Since(start).Seconds())
+	if err != nil {
+		fetchErrorsTotal.WithLabelValues(providerName, "fetch").Inc()
+		logger.Printf("Failed to fetch temperatures.\nError: %s", err.Error())
+		return
+	}
+
+	var tempSum float64
+	var tempCount int
+	for _, result := range results {
+		if result.err != nil {
+			fetchErrorsTotal.WithLabelValues(providerName, errorKind(result.err)).Inc()
+			continue
+		}
+		lat := strconv.FormatFloat(result.coord.Latitude, 'f', 4, 64)
+		lon := strconv.FormatFloat(result.coord.Longitude, 'f', 4, 64)
+		temperatureGauge.WithLabelValues(result.coord.Name, lat, lon).Set(result.reading.Temperature)
+		humidityGauge.WithLabelValues(result.coord.Name, lat, lon).Set(result.reading.Humidity)
+		windSpeedGauge.WithLabelValues(result.coord.Name, lat, lon).Set(result.reading.WindSpeed)
+		tempSum += result.reading.Temperature
+		tempCount++
+	}
+
+	if tempCount > 0 {
+		averageTemperatureGauge.Set(tempSum / float64(tempCount))
+	}
+
+	if err := writeReport(results); err != nil {
+		logger.Printf("Failed to write weather report.\nError: %s", err.Error())
+	}
+
+	logger.Printf("Updated temperature metrics for %d of %d cities.", tempCount, len(coords))
+}
+
+// errorKind classifies a per-city fetch error into a Prometheus label value, so fetch_errors_total can actually
+// distinguish a provider-wide outage (timeouts, connection failures) from the occasional city with no reading.
+func errorKind(err error) string {
+	switch {
+	case errors.Is(err, providers.ErrNoTemperature):
+		return "no_temperature"
+	case errors.Is(err, context.DeadlineExceeded):
+		return "timeout"
+	case errors.Is(err, context.Canceled):
+		return "canceled"
+	default:
+		return "other"
+	}
+}
+
+// metricsAddr returns the address the /metrics server listens on, read from METRICS_ADDR if set.
+func metricsAddr() string {
+	if addr := os.Getenv(metricsAddrEnv); addr != "" {
+		return addr
+	}
+	return defaultMetricsAddr
+}
+
+// fetchInterval returns how often the collector re-fetches every city's temperature, read from FETCH_INTERVAL if
+// set and valid.
+func fetchInterval() time.Duration {
+	if raw := os.Getenv(fetchIntervalEnv); raw != "" {
+		if d, err := time.ParseDuration(raw); err == nil && d > 0 {
+			return d
+		}
+	}
+	return defaultFetchInterval
+}
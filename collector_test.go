@@ -0,0 +1,30 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/MicahParks/temperature-challenge/providers"
+)
+
+func TestErrorKind(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want string
+	}{
+		{"no temperature", providers.ErrNoTemperature, "no_temperature"},
+		{"timeout", context.DeadlineExceeded, "timeout"},
+		{"canceled", context.Canceled, "canceled"},
+		{"other", errors.New("connection refused"), "other"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := errorKind(test.err); got != test.want {
+				t.Errorf("errorKind(%v) = %q, want %q", test.err, got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,87 @@
+package main
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/MicahParks/temperature-challenge/locations"
+	"github.com/MicahParks/temperature-challenge/providers"
+)
+
+const (
+
+	// defaultConcurrency is how many cities are fetched at once when concurrency isn't overridden.
+	defaultConcurrency = 10
+
+	// perCityTimeout bounds how long a single city's temperature fetch may take before it's counted as failed.
+	perCityTimeout = 10 * time.Second
+)
+
+// fetchResult is what a worker sends back for a single city.
+type fetchResult struct {
+	coord   locations.Location
+	reading providers.Reading
+	err     error
+}
+
+// fetchAll fetches the current temperature for every location in locs using a pool of concurrency workers, honoring
+// ctx for cancellation and a per-location timeout derived from it. A fetchResult is returned for every location,
+// including ones that failed, so one flaky or slow city never blanks out the rest of the batch; callers that need
+// per-location detail (e.g. metrics) can inspect each fetchResult's err. The only way fetchAll itself returns an
+// error is ctx being canceled before any results could be gathered at all.
+func fetchAll(ctx context.Context, provider providers.WeatherProvider, locs []locations.Location, concurrency int) ([]fetchResult, error) {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	jobs := make(chan locations.Location)
+	results := make(chan fetchResult)
+
+	var wg sync.WaitGroup
+	wg.Add(concurrency)
+	for i := 0; i < concurrency; i++ {
+		go func() {
+			defer wg.Done()
+			for coord := range jobs {
+				results <- fetchOne(ctx, provider, coord)
+			}
+		}()
+	}
+
+	go func() {
+		defer close(jobs)
+		for _, loc := range locs {
+			select {
+			case jobs <- loc:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	all := make([]fetchResult, 0, len(locs))
+	for result := range results {
+		all = append(all, result)
+	}
+
+	if len(all) == 0 && len(locs) > 0 {
+		return nil, ctx.Err()
+	}
+
+	return all, nil
+}
+
+// fetchOne fetches a single city's current Reading, applying perCityTimeout to ctx.
+func fetchOne(ctx context.Context, provider providers.WeatherProvider, coord locations.Location) fetchResult {
+	ctx, cancel := context.WithTimeout(ctx, perCityTimeout)
+	defer cancel()
+
+	reading, err := provider.CurrentReading(ctx, coord.Latitude, coord.Longitude)
+	return fetchResult{coord: coord, reading: reading, err: err}
+}
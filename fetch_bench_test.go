@@ -0,0 +1,75 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/MicahParks/temperature-challenge/locations"
+	"github.com/MicahParks/temperature-challenge/providers"
+)
+
+// delayedProvider simulates a WeatherProvider with network latency, without making real HTTP calls, so the serial
+// and concurrent benchmarks below measure the fetch pipeline itself rather than a live API.
+type delayedProvider struct {
+	delay time.Duration
+}
+
+func (d delayedProvider) CurrentReading(ctx context.Context, _, _ float64) (providers.Reading, error) {
+	select {
+	case <-time.After(d.delay):
+		return providers.Reading{Temperature: 70}, nil
+	case <-ctx.Done():
+		return providers.Reading{}, ctx.Err()
+	}
+}
+
+func (d delayedProvider) Forecast(_ context.Context, _, _ float64, days int) ([]providers.Reading, error) {
+	return make([]providers.Reading, days), nil
+}
+
+// benchCoords builds n coordinates to fetch during benchmarking.
+func benchCoords(n int) []locations.Location {
+	coords := make([]locations.Location, n)
+	for i := range coords {
+		coords[i] = locations.Location{Latitude: float64(i), Longitude: float64(i)}
+	}
+	return coords
+}
+
+// fetchTemperaturesSerial is the original one-at-a-time loop, kept here only as a benchmark baseline for fetchAll.
+func fetchTemperaturesSerial(ctx context.Context, provider providers.WeatherProvider, coords []locations.Location) (tempSum float64, tempCount int, err error) {
+	for _, coord := range coords {
+		reading, readingErr := provider.CurrentReading(ctx, coord.Latitude, coord.Longitude)
+		if readingErr != nil {
+			return 0, 0, readingErr
+		}
+		tempCount++
+		tempSum += reading.Temperature
+	}
+	return tempSum, tempCount, nil
+}
+
+func BenchmarkFetchTemperaturesSerial(b *testing.B) {
+	provider := delayedProvider{delay: time.Millisecond}
+	coords := benchCoords(100)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, _, err := fetchTemperaturesSerial(context.Background(), provider, coords); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkFetchTemperaturesConcurrent(b *testing.B) {
+	provider := delayedProvider{delay: time.Millisecond}
+	coords := benchCoords(100)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := fetchAll(context.Background(), provider, coords, defaultConcurrency); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,63 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/MicahParks/temperature-challenge/providers"
+)
+
+// errOneCity is returned by flakyProvider for the one coordinate it's configured to fail.
+var errOneCity = errors.New("simulated failure for one city")
+
+// flakyProvider succeeds for every coordinate except failAt, which always errors, so fetchAll's handling of a
+// single bad city can be exercised without a real network call.
+type flakyProvider struct {
+	failAt float64
+}
+
+func (f flakyProvider) CurrentReading(_ context.Context, lat, _ float64) (providers.Reading, error) {
+	if lat == f.failAt {
+		return providers.Reading{}, errOneCity
+	}
+	return providers.Reading{Temperature: 70}, nil
+}
+
+func (f flakyProvider) Forecast(_ context.Context, _, _ float64, days int) ([]providers.Reading, error) {
+	return make([]providers.Reading, days), nil
+}
+
+// TestFetchAllSurvivesOneCityError ensures one city's fetch failure doesn't discard the rest of the batch's
+// results, per fetchAll's documented behavior.
+func TestFetchAllSurvivesOneCityError(t *testing.T) {
+	locs := benchCoords(5)
+	provider := flakyProvider{failAt: locs[2].Latitude}
+
+	results, err := fetchAll(context.Background(), provider, locs, defaultConcurrency)
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err)
+	}
+	if len(results) != len(locs) {
+		t.Fatalf("expected %d results, got %d", len(locs), len(results))
+	}
+
+	var failures, successes int
+	for _, result := range results {
+		switch {
+		case errors.Is(result.err, errOneCity):
+			failures++
+		case result.err == nil:
+			successes++
+		default:
+			t.Fatalf("unexpected error: %s", result.err)
+		}
+	}
+
+	if failures != 1 {
+		t.Fatalf("expected exactly 1 failed city, got %d", failures)
+	}
+	if successes != len(locs)-1 {
+		t.Fatalf("expected %d successful cities, got %d", len(locs)-1, successes)
+	}
+}
@@ -0,0 +1,112 @@
+// Package httputil provides a resilient wrapper around *http.Client for the outbound calls this tool makes to
+// public weather and geocoding APIs, most of which are rate limited and occasionally flaky.
+package httputil
+
+import (
+	"math"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// Doer is satisfied by *http.Client and by *RetryDoer, so callers can depend on the interface rather than a concrete
+// type.
+type Doer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// RetryDoer wraps an http.Client with exponential-backoff retry and a token-bucket rate limit, so callers don't have
+// to hand-roll retry/rate-limit logic around every outbound request.
+type RetryDoer struct {
+	client     Doer
+	limiter    *rate.Limiter
+	maxRetries int
+	baseDelay  time.Duration
+	maxDelay   time.Duration
+}
+
+// NewRetryDoer creates a RetryDoer around client. ratePerMinute and burst configure the token-bucket rate limit,
+// matching an API tier's "calls per minute" allowance; maxRetries bounds how many times a transient failure is
+// retried. If client is nil, http.DefaultClient is used.
+func NewRetryDoer(client Doer, ratePerMinute float64, burst, maxRetries int) *RetryDoer {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &RetryDoer{
+		client:     client,
+		limiter:    rate.NewLimiter(rate.Limit(ratePerMinute/60), burst),
+		maxRetries: maxRetries,
+		baseDelay:  500 * time.Millisecond,
+		maxDelay:   30 * time.Second,
+	}
+}
+
+// Do performs req, waiting for rate limiter availability and retrying with exponential backoff and jitter on
+// transient failures (network errors, HTTP 429, and HTTP 5xx). It honors a Retry-After header when the response
+// includes one.
+func (r *RetryDoer) Do(req *http.Request) (resp *http.Response, err error) {
+	for attempt := 0; ; attempt++ {
+		if err = r.limiter.Wait(req.Context()); err != nil {
+			return nil, err
+		}
+
+		resp, err = r.client.Do(req)
+		if err == nil && !isRetryable(resp.StatusCode) {
+			return resp, nil
+		}
+		if attempt >= r.maxRetries {
+			return resp, err
+		}
+
+		delay := retryAfter(resp)
+		if delay == 0 {
+			delay = backoff(attempt, r.baseDelay, r.maxDelay)
+		}
+		if resp != nil {
+			resp.Body.Close() // Ignore this error, if any.
+		}
+
+		select {
+		case <-time.After(delay):
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		}
+	}
+}
+
+// isRetryable reports whether an HTTP status code indicates a transient failure worth retrying.
+func isRetryable(statusCode int) bool {
+	return statusCode == http.StatusTooManyRequests || statusCode >= http.StatusInternalServerError
+}
+
+// retryAfter parses a Retry-After header from resp, in seconds, returning 0 if absent or unparseable.
+func retryAfter(resp *http.Response) time.Duration {
+	if resp == nil {
+		return 0
+	}
+	seconds, err := strconv.Atoi(resp.Header.Get("Retry-After"))
+	if err != nil || seconds <= 0 {
+		return 0
+	}
+	return time.Duration(seconds) * time.Second
+}
+
+// maxBackoffAttempt caps the attempt number fed into 2^attempt below, so a caller configured with a very large
+// maxRetries can't overflow the delay computation.
+const maxBackoffAttempt = 6
+
+// backoff computes an exponential backoff delay for the given attempt number, with up to 50% jitter, capped at max.
+func backoff(attempt int, base, max time.Duration) time.Duration {
+	if attempt > maxBackoffAttempt {
+		attempt = maxBackoffAttempt
+	}
+	delay := base * time.Duration(math.Pow(2, float64(attempt)))
+	if delay > max {
+		delay = max
+	}
+	jitter := time.Duration(rand.Int63n(int64(delay)/2 + 1))
+	return delay/2 + jitter
+}
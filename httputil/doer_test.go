@@ -0,0 +1,87 @@
+package httputil
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+)
+
+// countingDoer returns an in-memory response with a canned status code for a configurable number of attempts
+// before succeeding, so RetryDoer's retry logic can be exercised without real network flakiness.
+type countingDoer struct {
+	failures   int
+	statusCode int
+	attempts   int
+}
+
+func (c *countingDoer) Do(req *http.Request) (*http.Response, error) {
+	c.attempts++
+	status := http.StatusOK
+	if c.attempts <= c.failures {
+		status = c.statusCode
+	}
+	return &http.Response{
+		StatusCode: status,
+		Body:       http.NoBody,
+		Header:     make(http.Header),
+	}, nil
+}
+
+func TestRetryDoerRetriesTransientFailures(t *testing.T) {
+	inner := &countingDoer{failures: 2, statusCode: http.StatusServiceUnavailable}
+	doer := NewRetryDoer(inner, 1_000_000, 10, 3)
+	doer.baseDelay = 0
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, "http://example.com", nil)
+	if err != nil {
+		t.Fatalf("failed to build request: %s", err)
+	}
+
+	resp, err := doer.Do(req)
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, resp.StatusCode)
+	}
+	if inner.attempts != 3 {
+		t.Fatalf("expected 3 attempts, got %d", inner.attempts)
+	}
+}
+
+func TestRetryDoerGivesUpAfterMaxRetries(t *testing.T) {
+	inner := &countingDoer{failures: 10, statusCode: http.StatusTooManyRequests}
+	doer := NewRetryDoer(inner, 1_000_000, 10, 2)
+	doer.baseDelay = 0
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, "http://example.com", nil)
+	if err != nil {
+		t.Fatalf("failed to build request: %s", err)
+	}
+
+	resp, err := doer.Do(req)
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err)
+	}
+	if resp.StatusCode != http.StatusTooManyRequests {
+		t.Fatalf("expected status %d, got %d", http.StatusTooManyRequests, resp.StatusCode)
+	}
+	if inner.attempts != 3 {
+		t.Fatalf("expected 3 attempts (1 + 2 retries), got %d", inner.attempts)
+	}
+}
+
+// TestBackoffDoesNotOverflow exercises an attempt number far beyond any caller's maxRetries today, to guard against
+// 2^attempt overflowing into a negative time.Duration and panicking the rand.Int63n call below it.
+func TestBackoffDoesNotOverflow(t *testing.T) {
+	const base = 500 * time.Millisecond
+	const max = 30 * time.Second
+
+	for _, attempt := range []int{6, 35, 1000} {
+		delay := backoff(attempt, base, max)
+		if delay < 0 || delay > max {
+			t.Fatalf("attempt %d: expected a delay in [0, %s], got %s", attempt, max, delay)
+		}
+	}
+}
@@ -0,0 +1,61 @@
+package locations
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// fileLocation mirrors Location with struct tags, since Location itself has no need for serialization tags outside
+// of this one Source.
+type fileLocation struct {
+	Name      string  `json:"name" yaml:"name"`
+	Latitude  float64 `json:"lat" yaml:"lat"`
+	Longitude float64 `json:"lon" yaml:"lon"`
+}
+
+// FileSource is a Source backed by a user-supplied file of locations, in either JSON or YAML, selected by the
+// file's extension (".json" for JSON, anything else for YAML). Each entry is a {name, lat, lon} object.
+type FileSource struct {
+	Path string
+}
+
+// NewFileSource creates a FileSource that reads locations from the file at path.
+func NewFileSource(path string) *FileSource {
+	return &FileSource{Path: path}
+}
+
+// Locations implements the Source interface. ctx is accepted to satisfy the interface, but reading a local file
+// isn't cancelable.
+func (f *FileSource) Locations(_ context.Context) ([]Location, error) {
+	raw, err := ioutil.ReadFile(f.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	var fileLocs []fileLocation
+	if strings.EqualFold(filepath.Ext(f.Path), ".json") {
+		err = json.Unmarshal(raw, &fileLocs)
+	} else {
+		err = yaml.Unmarshal(raw, &fileLocs)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", f.Path, err)
+	}
+
+	locs := make([]Location, len(fileLocs))
+	for i, fileLoc := range fileLocs {
+		locs[i] = Location{
+			Name:      fileLoc.Name,
+			Latitude:  fileLoc.Latitude,
+			Longitude: fileLoc.Longitude,
+		}
+	}
+
+	return locs, nil
+}
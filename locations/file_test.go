@@ -0,0 +1,50 @@
+package locations
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestFileSourceLocationsJSON(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "locations.json")
+	writeFile(t, path, `[{"name": "Chicago", "lat": 41.85, "lon": -87.65}]`)
+
+	source := NewFileSource(path)
+	locs, err := source.Locations(context.Background())
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err)
+	}
+	if len(locs) != 1 || locs[0].Name != "Chicago" || locs[0].Latitude != 41.85 || locs[0].Longitude != -87.65 {
+		t.Errorf("unexpected locations: %+v", locs)
+	}
+}
+
+func TestFileSourceLocationsYAML(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "locations.yaml")
+	writeFile(t, path, "- name: Chicago\n  lat: 41.85\n  lon: -87.65\n")
+
+	source := NewFileSource(path)
+	locs, err := source.Locations(context.Background())
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err)
+	}
+	if len(locs) != 1 || locs[0].Name != "Chicago" || locs[0].Latitude != 41.85 || locs[0].Longitude != -87.65 {
+		t.Errorf("unexpected locations: %+v", locs)
+	}
+}
+
+func TestFileSourceLocationsMissingFile(t *testing.T) {
+	source := NewFileSource(filepath.Join(t.TempDir(), "does-not-exist.json"))
+	if _, err := source.Locations(context.Background()); err == nil {
+		t.Fatal("expected an error for a missing file, got none")
+	}
+}
+
+func writeFile(t *testing.T, path, contents string) {
+	t.Helper()
+	if err := os.WriteFile(path, []byte(contents), 0o644); err != nil {
+		t.Fatalf("failed to write %s: %s", path, err)
+	}
+}
@@ -0,0 +1,85 @@
+package locations
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+
+	"github.com/tidwall/gjson"
+
+	"github.com/MicahParks/temperature-challenge/httputil"
+)
+
+// geocodeDirectURLTemplate is OpenWeatherMap's direct city-name geocoding endpoint.
+const geocodeDirectURLTemplate = "https://api.openweathermap.org/geo/1.0/direct?q=%s&limit=1&appid=%s"
+
+// ErrCityNotFound indicates OpenWeatherMap's geocoder had no match for a requested city name.
+var ErrCityNotFound = errors.New("no matching city was found")
+
+// CityNameSource is a Source that resolves a fixed list of city names (e.g. "London,GB" or "Chicago,IL,US") to
+// coordinates using OpenWeatherMap's direct geocoding endpoint.
+type CityNameSource struct {
+	APIKey string
+	Cities []string
+	Doer   httputil.Doer
+}
+
+// NewCityNameSource creates a CityNameSource. If doer is nil, http.DefaultClient is used directly, with no retry or
+// rate limiting.
+func NewCityNameSource(apiKey string, cities []string, doer httputil.Doer) *CityNameSource {
+	if doer == nil {
+		doer = http.DefaultClient
+	}
+	return &CityNameSource{
+		APIKey: apiKey,
+		Cities: cities,
+		Doer:   doer,
+	}
+}
+
+// Locations implements the Source interface.
+func (c *CityNameSource) Locations(ctx context.Context) (locs []Location, err error) {
+	locs = make([]Location, 0, len(c.Cities))
+	for _, city := range c.Cities {
+		var loc Location
+		if loc, err = c.locateOne(ctx, city); err != nil {
+			return nil, fmt.Errorf("geocoding %q: %w", city, err)
+		}
+		locs = append(locs, loc)
+	}
+	return locs, nil
+}
+
+func (c *CityNameSource) locateOne(ctx context.Context, city string) (loc Location, err error) {
+	geocodeURL := fmt.Sprintf(geocodeDirectURLTemplate, url.QueryEscape(city), c.APIKey)
+
+	var req *http.Request
+	if req, err = http.NewRequestWithContext(ctx, http.MethodGet, geocodeURL, nil); err != nil {
+		return Location{}, err
+	}
+
+	var resp *http.Response
+	if resp, err = c.Doer.Do(req); err != nil {
+		return Location{}, err
+	}
+	defer resp.Body.Close() // Ignore this error, if any.
+
+	var respJSON []byte
+	if respJSON, err = ioutil.ReadAll(resp.Body); err != nil {
+		return Location{}, err
+	}
+
+	first := gjson.GetBytes(respJSON, "0")
+	if !first.Exists() {
+		return Location{}, ErrCityNotFound
+	}
+
+	return Location{
+		Name:      first.Get("name").String(),
+		Latitude:  first.Get("lat").Float(),
+		Longitude: first.Get("lon").Float(),
+	}, nil
+}
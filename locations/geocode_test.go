@@ -0,0 +1,50 @@
+package locations
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+// stubDoer returns a canned response for every request, so a Source's field-mapping logic can be tested without a
+// real network call.
+type stubDoer struct {
+	body string
+}
+
+func (s stubDoer) Do(_ *http.Request) (*http.Response, error) {
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Body:       io.NopCloser(strings.NewReader(s.body)),
+		Header:     make(http.Header),
+	}, nil
+}
+
+func TestCityNameSourceLocations(t *testing.T) {
+	source := NewCityNameSource("test-key", []string{"Chicago,IL,US", "London,GB"}, stubDoer{
+		body: `[{"name": "Chicago", "lat": 41.85, "lon": -87.65}]`,
+	})
+
+	locs, err := source.Locations(context.Background())
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err)
+	}
+	if len(locs) != 2 {
+		t.Fatalf("expected 2 locations, got %d", len(locs))
+	}
+	for _, loc := range locs {
+		if loc.Name != "Chicago" || loc.Latitude != 41.85 || loc.Longitude != -87.65 {
+			t.Errorf("unexpected location: %+v", loc)
+		}
+	}
+}
+
+func TestCityNameSourceNotFound(t *testing.T) {
+	source := NewCityNameSource("test-key", []string{"Nowhere,XX"}, stubDoer{body: `[]`})
+
+	if _, err := source.Locations(context.Background()); err == nil {
+		t.Fatal("expected an error for an unmatched city, got none")
+	}
+}
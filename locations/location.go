@@ -0,0 +1,23 @@
+// Package locations provides the set of cities (or other points) this tool fetches weather for. The universe used
+// to be hardcoded to the 100 most populous US cities; Source lets that be swapped for a handful of named cities, a
+// ZIP code, or a user-supplied file instead.
+package locations
+
+import "context"
+
+// Location is a single point this tool fetches weather for.
+type Location struct {
+
+	// Name identifies the location, e.g. a city name. It is used only for display and metric labels.
+	Name string
+
+	Latitude  float64
+	Longitude float64
+}
+
+// Source is implemented by anything that can produce a set of Locations to fetch weather for.
+type Source interface {
+
+	// Locations returns the set of Locations to fetch weather for.
+	Locations(ctx context.Context) ([]Location, error)
+}
@@ -0,0 +1,90 @@
+package locations
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/tidwall/gjson"
+
+	"github.com/MicahParks/temperature-challenge/httputil"
+)
+
+// opendatasoftURLTemplate queries opendatasoft's geonames dataset for the most populous cities in a country.
+//
+// It's written to add sorting and only the fields we care about. Let the server do all the work!
+const opendatasoftURLTemplate = "https://public.opendatasoft.com/api/records/1.0/search/?rows=%d&disjunctive.country=true&refine.country=%s&sort=%s&start=0&fields=ascii_name,coordinates&dataset=geonames-all-cities-with-a-population-1000&timezone=UTC&lang=en"
+
+// ErrNotEnoughCities indicates opendatasoft returned fewer cities than were asked for.
+var ErrNotEnoughCities = errors.New("opendatasoft did not return as many cities as were requested")
+
+// OpendatasoftSource is a Source that queries opendatasoft's geonames dataset for the most populous cities in a
+// country. It's the original, default behavior of this tool, generalized to any country, row count, and sort.
+type OpendatasoftSource struct {
+
+	// Country is the country to filter cities to, e.g. "United+States".
+	Country string
+
+	// Rows is how many cities to request.
+	Rows int
+
+	// Sort is the field opendatasoft sorts by, e.g. "population" or "-population".
+	Sort string
+
+	Doer httputil.Doer
+}
+
+// NewOpendatasoftSource creates an OpendatasoftSource with the given parameters. If doer is nil,
+// http.DefaultClient is used directly, with no retry or rate limiting.
+func NewOpendatasoftSource(country string, rows int, sort string, doer httputil.Doer) *OpendatasoftSource {
+	if doer == nil {
+		doer = http.DefaultClient
+	}
+	return &OpendatasoftSource{
+		Country: country,
+		Rows:    rows,
+		Sort:    sort,
+		Doer:    doer,
+	}
+}
+
+// Locations implements the Source interface.
+func (o *OpendatasoftSource) Locations(ctx context.Context) (locs []Location, err error) {
+	url := fmt.Sprintf(opendatasoftURLTemplate, o.Rows, o.Country, o.Sort)
+
+	var req *http.Request
+	if req, err = http.NewRequestWithContext(ctx, http.MethodGet, url, nil); err != nil {
+		return nil, err
+	}
+
+	var resp *http.Response
+	if resp, err = o.Doer.Do(req); err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close() // Ignore this error, if any.
+
+	var respJSON []byte
+	if respJSON, err = ioutil.ReadAll(resp.Body); err != nil {
+		return nil, err
+	}
+
+	// Create a gjson.Result that will let us iterate through the fields of each record returned in the response.
+	records := gjson.GetBytes(respJSON, "records.#.fields").Array()
+
+	locs = make([]Location, len(records))
+	for i, cityJSON := range records {
+		locs[i] = Location{
+			Name:      cityJSON.Get("ascii_name").String(),
+			Latitude:  cityJSON.Get("coordinates.0").Float(),
+			Longitude: cityJSON.Get("coordinates.1").Float(),
+		}
+	}
+
+	if len(locs) < o.Rows {
+		return locs, ErrNotEnoughCities
+	}
+
+	return locs, nil
+}
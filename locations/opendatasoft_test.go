@@ -0,0 +1,43 @@
+package locations
+
+import (
+	"context"
+	"testing"
+)
+
+const opendatasoftSampleResponse = `{
+	"records": [
+		{"fields": {"ascii_name": "New York", "coordinates": [40.71, -74.01]}},
+		{"fields": {"ascii_name": "Los Angeles", "coordinates": [34.05, -118.24]}}
+	]
+}`
+
+func TestOpendatasoftSourceLocations(t *testing.T) {
+	source := NewOpendatasoftSource("United+States", 2, "population", stubDoer{body: opendatasoftSampleResponse})
+
+	locs, err := source.Locations(context.Background())
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err)
+	}
+	if len(locs) != 2 {
+		t.Fatalf("expected 2 locations, got %d", len(locs))
+	}
+	if locs[0].Name != "New York" || locs[0].Latitude != 40.71 || locs[0].Longitude != -74.01 {
+		t.Errorf("unexpected first location: %+v", locs[0])
+	}
+	if locs[1].Name != "Los Angeles" || locs[1].Latitude != 34.05 || locs[1].Longitude != -118.24 {
+		t.Errorf("unexpected second location: %+v", locs[1])
+	}
+}
+
+func TestOpendatasoftSourceNotEnoughCities(t *testing.T) {
+	source := NewOpendatasoftSource("United+States", 100, "population", stubDoer{body: opendatasoftSampleResponse})
+
+	locs, err := source.Locations(context.Background())
+	if err != ErrNotEnoughCities {
+		t.Fatalf("expected ErrNotEnoughCities, got: %v", err)
+	}
+	if len(locs) != 2 {
+		t.Fatalf("expected the 2 cities that were returned, got %d", len(locs))
+	}
+}
@@ -0,0 +1,85 @@
+package locations
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+
+	"github.com/tidwall/gjson"
+
+	"github.com/MicahParks/temperature-challenge/httputil"
+)
+
+// geocodeZIPURLTemplate is OpenWeatherMap's ZIP-code geocoding endpoint.
+const geocodeZIPURLTemplate = "https://api.openweathermap.org/geo/1.0/zip?zip=%s&appid=%s"
+
+// ErrZIPNotFound indicates OpenWeatherMap's geocoder had no match for a requested ZIP code.
+var ErrZIPNotFound = errors.New("no matching ZIP code was found")
+
+// ZIPSource is a Source that resolves a fixed list of ZIP codes (e.g. "94040,US") to coordinates using
+// OpenWeatherMap's ZIP-code geocoding endpoint.
+type ZIPSource struct {
+	APIKey string
+	ZIPs   []string
+	Doer   httputil.Doer
+}
+
+// NewZIPSource creates a ZIPSource. If doer is nil, http.DefaultClient is used directly, with no retry or rate
+// limiting.
+func NewZIPSource(apiKey string, zips []string, doer httputil.Doer) *ZIPSource {
+	if doer == nil {
+		doer = http.DefaultClient
+	}
+	return &ZIPSource{
+		APIKey: apiKey,
+		ZIPs:   zips,
+		Doer:   doer,
+	}
+}
+
+// Locations implements the Source interface.
+func (z *ZIPSource) Locations(ctx context.Context) (locs []Location, err error) {
+	locs = make([]Location, 0, len(z.ZIPs))
+	for _, zip := range z.ZIPs {
+		var loc Location
+		if loc, err = z.locateOne(ctx, zip); err != nil {
+			return nil, fmt.Errorf("geocoding ZIP %q: %w", zip, err)
+		}
+		locs = append(locs, loc)
+	}
+	return locs, nil
+}
+
+func (z *ZIPSource) locateOne(ctx context.Context, zip string) (loc Location, err error) {
+	geocodeURL := fmt.Sprintf(geocodeZIPURLTemplate, url.QueryEscape(zip), z.APIKey)
+
+	var req *http.Request
+	if req, err = http.NewRequestWithContext(ctx, http.MethodGet, geocodeURL, nil); err != nil {
+		return Location{}, err
+	}
+
+	var resp *http.Response
+	if resp, err = z.Doer.Do(req); err != nil {
+		return Location{}, err
+	}
+	defer resp.Body.Close() // Ignore this error, if any.
+
+	var respJSON []byte
+	if respJSON, err = ioutil.ReadAll(resp.Body); err != nil {
+		return Location{}, err
+	}
+
+	name := gjson.GetBytes(respJSON, "name")
+	if !name.Exists() {
+		return Location{}, ErrZIPNotFound
+	}
+
+	return Location{
+		Name:      name.String(),
+		Latitude:  gjson.GetBytes(respJSON, "lat").Float(),
+		Longitude: gjson.GetBytes(respJSON, "lon").Float(),
+	}, nil
+}
@@ -0,0 +1,33 @@
+package locations
+
+import (
+	"context"
+	"testing"
+)
+
+func TestZIPSourceLocations(t *testing.T) {
+	source := NewZIPSource("test-key", []string{"94040,US", "10001,US"}, stubDoer{
+		body: `{"name": "Mountain View", "lat": 37.39, "lon": -122.08}`,
+	})
+
+	locs, err := source.Locations(context.Background())
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err)
+	}
+	if len(locs) != 2 {
+		t.Fatalf("expected 2 locations, got %d", len(locs))
+	}
+	for _, loc := range locs {
+		if loc.Name != "Mountain View" || loc.Latitude != 37.39 || loc.Longitude != -122.08 {
+			t.Errorf("unexpected location: %+v", loc)
+		}
+	}
+}
+
+func TestZIPSourceNotFound(t *testing.T) {
+	source := NewZIPSource("test-key", []string{"00000,XX"}, stubDoer{body: `{}`})
+
+	if _, err := source.Locations(context.Background()); err == nil {
+		t.Fatal("expected an error for an unmatched ZIP code, got none")
+	}
+}
@@ -1,48 +1,56 @@
 package main
 
 import (
+	"context"
 	"errors"
-	"fmt"
-	"io/ioutil"
+	"flag"
 	"log"
 	"net/http"
 	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+	"syscall"
 	"time"
 
-	"github.com/tidwall/gjson"
+	"github.com/MicahParks/temperature-challenge/httputil"
+	"github.com/MicahParks/temperature-challenge/locations"
+	"github.com/MicahParks/temperature-challenge/providers"
 )
 
 const (
 
-	// cityURL is the URL with the query to the most populous US cities.
-	//
-	// I modified this URL a bit to add sorting and only the fields I cared about. Let the server do all the work!
-	cityURL = "https://public.opendatasoft.com/api/records/1.0/search/?rows=100&disjunctive.country=true&refine.country=United+States&sort=population&start=0&fields=coordinates&dataset=geonames-all-cities-with-a-population-1000&timezone=UTC&lang=en"
+	// weatherProviderEnv selects which providers.WeatherProvider implementation main uses. See newWeatherProvider
+	// for the accepted values.
+	weatherProviderEnv = "WEATHER_PROVIDER"
 
-	// The URL template that will turn a pair of coordinates into a Where On Earth (WOE) ID.
-	woeIDURLTemplate = "https://www.metaweather.com/api/location/search/?lattlong=%f,%f"
+	// openWeatherMapAPIKeyEnv holds the API key used by the OpenWeatherMap provider and the OpenWeatherMap-backed
+	// location sources ("city" and "zip").
+	openWeatherMapAPIKeyEnv = "OPENWEATHERMAP_API_KEY"
 
-	// The URL template that will turn a WOE ID and date into a temperature reading.
-	temperatureURLTemplate = "https://www.metaweather.com/api/location/%d/%d/%d/%d"
-)
+	// defaultWeatherProviderName is used when weatherProviderEnv isn't set.
+	defaultWeatherProviderName = "open-meteo"
 
-var (
+	// concurrencyEnv overrides how many locations are fetched in parallel. See defaultConcurrency in fetch.go.
+	concurrencyEnv = "WEATHER_CONCURRENCY"
 
-	// ErrNot100Cities indicates the HTTP request to get the most populous 100 cities in the US did not include at least
-	// 100 cities.
-	ErrNot100Cities = errors.New("100 cities were not returned by the HTTP response")
+	// globalDeadline bounds a single collection pass, not just one location's fetch.
+	globalDeadline = 60 * time.Second
 
-	// ErrNoTemperature indicates the HTTP request to get a city's temperature did not include a temperature.
-	ErrNoTemperature = errors.New("a temperature reading was not returned by the HTTP response")
+	// opendatasoftCallsPerMinute is a conservative rate limit for opendatasoft's public API, which publishes no
+	// official tier limits.
+	opendatasoftCallsPerMinute = 60
 
-	// ErrNoWoe indicates the HTTP request to get a city's WOE ID did not include a WOE ID.
-	ErrNoWoe = errors.New("a Where On Earth ID was not returned by the HTTP response")
-)
+	// openWeatherMapGeocodeCallsPerMinute matches OpenWeatherMap's free tier allowance, the same limit the
+	// OpenWeatherMap WeatherProvider uses, since the geocoding endpoints share that tier.
+	openWeatherMapGeocodeCallsPerMinute = 60
 
-type coordinates struct {
-	Latitude  float64 `json:"latitude"`
-	Longitude float64 `json:"longitude"`
-}
+	// defaultOpendatasoftCountry, defaultOpendatasoftRows, and defaultOpendatasoftSort reproduce this tool's
+	// original, hardcoded behavior: the 100 most populous US cities.
+	defaultOpendatasoftCountry = "United+States"
+	defaultOpendatasoftRows    = 100
+	defaultOpendatasoftSort    = "population"
+)
 
 func main() {
 
@@ -51,146 +59,103 @@ func main() {
 	// It will behave as an async safe printer, but could be updated for later.
 	logger := log.New(os.Stdout, "", 0)
 
-	// Create an HTTP client that will be reused for requests.
-	//
-	// By using an http.Client, we can more easily switch out the code to use proxies later, if desired.
-	httpClient := &http.Client{}
-
-	// Get the 100 largest US cities.
-	var err error
-	var coords [100]coordinates
-	if coords, err = largest100USCities(httpClient, cityURL); err != nil {
-		logger.Fatalf("Failed to get the 100 largest US cities.\nError: %s", err.Error())
+	locationsFlag := flag.String("locations", "opendatasoft", `Where to source locations from: "opendatasoft" (top US cities), "city" (semicolon-separated city names in -city-names), "zip" (semicolon-separated ZIP codes in -zip-codes), or "file" (a JSON or YAML file of locations, given by -locations-file).`)
+	cityNamesFlag := flag.String("city-names", "", `Semicolon-separated city names to geocode, each itself a comma-separated "city,state,country" query, e.g. "Chicago,IL,US;London,GB". Used when -locations=city.`)
+	zipCodesFlag := flag.String("zip-codes", "", `Semicolon-separated ZIP/postal codes to geocode, each itself a comma-separated "zip,country" query, e.g. "94040,US;10001,US". Used when -locations=zip.`)
+	locationsFileFlag := flag.String("locations-file", "", `Path to a JSON or YAML file of {name, lat, lon} locations. Used when -locations=file.`)
+	countryFlag := flag.String("country", defaultOpendatasoftCountry, `Country to filter cities to. Used when -locations=opendatasoft.`)
+	rowsFlag := flag.Int("rows", defaultOpendatasoftRows, `Number of cities to fetch. Used when -locations=opendatasoft.`)
+	sortFlag := flag.String("sort", defaultOpendatasoftSort, `Field opendatasoft sorts cities by. Used when -locations=opendatasoft.`)
+	flag.Parse()
+
+	// Pick a weather provider. MetaWeather, the original data source, has shut down, so this defaults to
+	// Open-Meteo, which needs no API key.
+	providerName := weatherProviderName()
+	provider, err := newWeatherProvider(nil)
+	if err != nil {
+		logger.Fatalf("Failed to create a weather provider.\nError: %s", err.Error())
 	}
 
-	// Create a temperature sum and count of how many temperatures are from that sum.
-	tempCount := float64(0)
-	tempSum := float64(0)
-
-	// Iterate through the coordinates and get their temperatures.
-	//
-	// It'd be pretty easy to make this faster by working asynchronously. I'd use a *sync.Mutex to lock the float64's
-	// above and put what's in this loop into some different goroutines. Ask me to do it if you want!
-	for _, coord := range coords {
-
-		// Get the Where On Earth ID of the city.
-		var woeID int64
-		if woeID, err = coordinateWOEID(coord, httpClient, woeIDURLTemplate); err != nil {
-			logger.Fatalf("Failed to get a WOE ID.\nError: %s", err.Error())
-		}
-
-		// Get the temperature of the city.
-		var temperature float64
-		if temperature, err = woeIDTemperature(httpClient, temperatureURLTemplate, woeID); err != nil {
-			if errors.Is(err, ErrNoTemperature) {
-				logger.Printf("Failed to get temperature for WOE ID: %d. Continuing anyways.", woeID)
-			} else {
-				logger.Fatalf("Failed to get temperature from WOE ID.\nError: %s", err.Error())
-			}
-		}
-
-		// Add to the total temperature sum.
-		tempCount += 1
-		tempSum += temperature
+	locationSource, err := newLocationSource(*locationsFlag, *cityNamesFlag, *zipCodesFlag, *locationsFileFlag, *countryFlag, *rowsFlag, *sortFlag)
+	if err != nil {
+		logger.Fatalf("Failed to create a location source.\nError: %s", err.Error())
 	}
 
-	// Divide by the number of temperature summed and print the desired number.
-	logger.Printf("The average temperature in the %d most populous US cities is: %.2fF", int(tempCount), tempSum/tempCount)
-}
-
-// coordinateWOEID turns a pair of coordinates into a WOE ID.
-func coordinateWOEID(coords coordinates, httpClient *http.Client, urlTemplate string) (woeID int64, err error) {
-
-	// Perform the request to get the Where On Earth (woe) ID.
-	var resp *http.Response
-	if resp, err = httpClient.Get(fmt.Sprintf(urlTemplate, coords.Latitude, coords.Longitude)); err != nil {
-		return 0, err
+	locsCtx, locsCancel := context.WithTimeout(context.Background(), globalDeadline)
+	locs, err := locationSource.Locations(locsCtx)
+	locsCancel()
+	if err != nil {
+		logger.Fatalf("Failed to get locations.\nError: %s", err.Error())
 	}
-	defer resp.Body.Close() // Ignore this error, if any.
 
-	// Read the body of the response.
-	var respJSON []byte
-	if respJSON, err = ioutil.ReadAll(resp.Body); err != nil {
-		return 0, err
-	}
+	// Serve /metrics and periodically refresh it until interrupted, rather than fetching once and exiting.
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
 
-	// Get the WOE ID of the closest city (first index).
-	woeID = gjson.GetBytes(respJSON, "0.woeid").Int()
-	if woeID == 0 {
-		return 0, ErrNoWoe
+	if err = runCollector(ctx, logger, provider, providerName, locs); err != nil {
+		logger.Fatalf("Collector exited with an error.\nError: %s", err.Error())
 	}
-
-	return woeID, nil
 }
 
-// largest100USCities gets the coordinates of the most populous 100 US cities.
-func largest100USCities(httpClient *http.Client, urlWithParams string) (coords [100]coordinates, err error) {
-
-	// Perform the HTTP request given the HTTP client.
-	var resp *http.Response
-	if resp, err = httpClient.Get(urlWithParams); err != nil {
-		return [100]coordinates{}, err
+// weatherProviderName returns the name of the weather provider in use, read from WEATHER_PROVIDER if set, for use
+// as a Prometheus label.
+func weatherProviderName() string {
+	if name := os.Getenv(weatherProviderEnv); name != "" {
+		return name
 	}
-	defer resp.Body.Close() // Ignore this error, if any.
-
-	// Read the body of the response.
-	var respJSON []byte
-	if respJSON, err = ioutil.ReadAll(resp.Body); err != nil {
-		return [100]coordinates{}, err
-	}
-
-	// Create a gjson.Result that will let us iterate through the coords returned in the response.
-	records := gjson.GetBytes(respJSON, "records.#.fields.coordinates")
-
-	// Declare these variables in the outer scope so that the index can be referenced once the loop is completed.
-	var index int
-	var cityJSON gjson.Result
-
-	// Iterate through the coords in the response.
-	for index, cityJSON = range records.Array() {
+	return defaultWeatherProviderName
+}
 
-		// Create the current coordinates.
-		currentCords := &coordinates{
-			Latitude:  cityJSON.Get("0").Float(),
-			Longitude: cityJSON.Get("1").Float(),
+// concurrency returns how many locations are fetched in parallel, read from WEATHER_CONCURRENCY if set and valid,
+// falling back to defaultConcurrency otherwise.
+func concurrency() int {
+	if raw := os.Getenv(concurrencyEnv); raw != "" {
+		if n, err := strconv.Atoi(raw); err == nil && n > 0 {
+			return n
 		}
-
-		// Put the coords into the array of coords.
-		coords[index] = *currentCords
-	}
-
-	// Confirm the index is at 99 to ensure 100 city coordinates were gathered.
-	if index != 99 {
-		return coords, ErrNot100Cities
 	}
-
-	return coords, nil
+	return defaultConcurrency
 }
 
-// woeIDTemperature turns a WOE ID into a temperature.
-func woeIDTemperature(httpClient *http.Client, urlTemplate string, woeID int64) (temperature float64, err error) {
-
-	// Get the current date from the OS.
-	year, month, day := time.Now().Date()
-
-	// Perform the request to get temperature readings.
-	var resp *http.Response
-	if resp, err = httpClient.Get(fmt.Sprintf(urlTemplate, woeID, year, month, day)); err != nil {
-		return 0, err
-	}
-	defer resp.Body.Close() // Ignore this error, if any.
-
-	// Read the body of the response.
-	var respJSON []byte
-	if respJSON, err = ioutil.ReadAll(resp.Body); err != nil {
-		return 0, err
+// newWeatherProvider builds the providers.WeatherProvider selected by the WEATHER_PROVIDER environment variable. If
+// doer is nil, each provider falls back to its own rate-limited httputil.RetryDoer. Accepted values are
+// "open-meteo" (the default, no API key required) and "openweathermap" (requires OPENWEATHERMAP_API_KEY to be set).
+func newWeatherProvider(doer httputil.Doer) (providers.WeatherProvider, error) {
+	switch os.Getenv(weatherProviderEnv) {
+	case "openweathermap":
+		return providers.NewOpenWeatherMap(os.Getenv(openWeatherMapAPIKeyEnv), doer)
+	case "open-meteo", "":
+		return providers.NewOpenMeteo(doer), nil
+	default:
+		return nil, errors.New("unknown " + weatherProviderEnv + ": " + os.Getenv(weatherProviderEnv))
 	}
+}
 
-	// Get the most recent temperature reading (first index).
-	temperature = gjson.GetBytes(respJSON, "0.the_temp").Float()
-	if temperature == 0 {
-		return 0, ErrNoTemperature
+// newLocationSource builds the locations.Source selected by kind, which corresponds 1:1 with the -locations flag in
+// main.
+func newLocationSource(kind, cityNames, zipCodes, locationsFile, country string, rows int, sort string) (locations.Source, error) {
+	switch kind {
+	case "opendatasoft":
+		doer := httputil.NewRetryDoer(http.DefaultClient, opendatasoftCallsPerMinute, 5, 3)
+		return locations.NewOpendatasoftSource(country, rows, sort, doer), nil
+	case "city":
+		if cityNames == "" {
+			return nil, errors.New("-city-names is required when -locations=city")
+		}
+		doer := httputil.NewRetryDoer(http.DefaultClient, openWeatherMapGeocodeCallsPerMinute, 5, 3)
+		return locations.NewCityNameSource(os.Getenv(openWeatherMapAPIKeyEnv), strings.Split(cityNames, ";"), doer), nil
+	case "zip":
+		if zipCodes == "" {
+			return nil, errors.New("-zip-codes is required when -locations=zip")
+		}
+		doer := httputil.NewRetryDoer(http.DefaultClient, openWeatherMapGeocodeCallsPerMinute, 5, 3)
+		return locations.NewZIPSource(os.Getenv(openWeatherMapAPIKeyEnv), strings.Split(zipCodes, ";"), doer), nil
+	case "file":
+		if locationsFile == "" {
+			return nil, errors.New("-locations-file is required when -locations=file")
+		}
+		return locations.NewFileSource(locationsFile), nil
+	default:
+		return nil, errors.New("unknown -locations value: " + kind)
 	}
-
-	return temperature, nil
 }
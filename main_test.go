@@ -0,0 +1,46 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/MicahParks/temperature-challenge/locations"
+)
+
+// TestNewLocationSourceCitySplitsOnSemicolon ensures multi-entry -city-names values are split between entries, not
+// within them, since each entry is itself a comma-separated "city,state,country" query.
+func TestNewLocationSourceCitySplitsOnSemicolon(t *testing.T) {
+	source, err := newLocationSource("city", "Chicago,IL,US;London,GB", "", "", "", 0, "")
+	if err != nil {
+		t.Fatalf("Failed to create a location source.\nError: %s", err.Error())
+	}
+
+	cityNameSource, ok := source.(*locations.CityNameSource)
+	if !ok {
+		t.Fatalf("Expected a *locations.CityNameSource, got %T", source)
+	}
+
+	expected := []string{"Chicago,IL,US", "London,GB"}
+	if !reflect.DeepEqual(cityNameSource.Cities, expected) {
+		t.Errorf("Expected cities %v, got %v", expected, cityNameSource.Cities)
+	}
+}
+
+// TestNewLocationSourceZIPSplitsOnSemicolon ensures multi-entry -zip-codes values are split between entries, not
+// within them, since each entry is itself a comma-separated "zip,country" query.
+func TestNewLocationSourceZIPSplitsOnSemicolon(t *testing.T) {
+	source, err := newLocationSource("zip", "", "94040,US;10001,US", "", "", 0, "")
+	if err != nil {
+		t.Fatalf("Failed to create a location source.\nError: %s", err.Error())
+	}
+
+	zipSource, ok := source.(*locations.ZIPSource)
+	if !ok {
+		t.Fatalf("Expected a *locations.ZIPSource, got %T", source)
+	}
+
+	expected := []string{"94040,US", "10001,US"}
+	if !reflect.DeepEqual(zipSource.ZIPs, expected) {
+		t.Errorf("Expected ZIPs %v, got %v", expected, zipSource.ZIPs)
+	}
+}
@@ -0,0 +1,47 @@
+package main
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+
+	// temperatureGauge reports the most recently fetched temperature for a single city.
+	temperatureGauge = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "temperature_fahrenheit",
+		Help: "The current temperature in Fahrenheit for a city, as of the last successful fetch.",
+	}, []string{"city", "lat", "lon"})
+
+	// humidityGauge reports the most recently fetched relative humidity for a single city.
+	humidityGauge = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "humidity_percent",
+		Help: "The current relative humidity percentage for a city, as of the last successful fetch.",
+	}, []string{"city", "lat", "lon"})
+
+	// windSpeedGauge reports the most recently fetched wind speed, in miles per hour, for a single city.
+	windSpeedGauge = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "wind_speed_mph",
+		Help: "The current wind speed in miles per hour for a city, as of the last successful fetch.",
+	}, []string{"city", "lat", "lon"})
+
+	// averageTemperatureGauge reports the rolling average temperature across every city in the last successful
+	// fetch.
+	averageTemperatureGauge = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "temperature_fahrenheit_average",
+		Help: "The rolling average temperature in Fahrenheit across all cities, as of the last fetch.",
+	})
+
+	// fetchErrorsTotal counts failed fetches by provider and error kind, so a dashboard can distinguish a
+	// provider-wide outage from the occasional city with no reading.
+	fetchErrorsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "fetch_errors_total",
+		Help: "The count of failed temperature fetches, by provider and error kind.",
+	}, []string{"provider", "kind"})
+
+	// fetchDurationSeconds measures how long a full fetch of every city took, by provider.
+	fetchDurationSeconds = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name: "fetch_duration_seconds",
+		Help: "The time it took to fetch every city's temperature, by provider.",
+	}, []string{"provider"})
+)
@@ -0,0 +1,157 @@
+package providers
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/tidwall/gjson"
+
+	"github.com/MicahParks/temperature-challenge/httputil"
+)
+
+// openMeteoCurrentURLTemplate is the URL template for Open-Meteo's current weather endpoint. Open-Meteo needs no API
+// key and returns temperature in Fahrenheit and wind in mph when asked via the unit params below. Precipitation is
+// left in Open-Meteo's default unit, millimeters.
+const openMeteoCurrentURLTemplate = "https://api.open-meteo.com/v1/forecast?latitude=%f&longitude=%f&current=temperature_2m,apparent_temperature,relative_humidity_2m,wind_speed_10m,wind_direction_10m,precipitation,surface_pressure,cloud_cover,weather_code&temperature_unit=fahrenheit&wind_speed_unit=mph"
+
+// openMeteoDailyURLTemplate additionally asks for daily aggregates so Forecast can return one rich Reading per day.
+const openMeteoDailyURLTemplate = "https://api.open-meteo.com/v1/forecast?latitude=%f&longitude=%f&daily=temperature_2m_max,apparent_temperature_max,relative_humidity_2m_mean,wind_speed_10m_max,wind_direction_10m_dominant,precipitation_probability_mean,precipitation_sum,surface_pressure_mean,cloud_cover_mean,weather_code&temperature_unit=fahrenheit&wind_speed_unit=mph&forecast_days=%d"
+
+// openMeteoFreeTierCallsPerMinute is the rate limit Open-Meteo asks non-commercial users to stay under.
+const openMeteoFreeTierCallsPerMinute = 600
+
+// OpenMeteo is a WeatherProvider backed by the Open-Meteo API. Unlike OpenWeatherMap, it requires no API key.
+type OpenMeteo struct {
+	doer httputil.Doer
+}
+
+// NewOpenMeteo creates a new OpenMeteo provider. If doer is nil, requests go through a httputil.RetryDoer rate
+// limited to Open-Meteo's free tier allowance.
+func NewOpenMeteo(doer httputil.Doer) *OpenMeteo {
+	if doer == nil {
+		doer = httputil.NewRetryDoer(http.DefaultClient, openMeteoFreeTierCallsPerMinute, 10, 3)
+	}
+	return &OpenMeteo{
+		doer: doer,
+	}
+}
+
+// CurrentReading implements the WeatherProvider interface.
+func (o *OpenMeteo) CurrentReading(ctx context.Context, lat, lon float64) (reading Reading, err error) {
+	url := fmt.Sprintf(openMeteoCurrentURLTemplate, lat, lon)
+
+	var req *http.Request
+	if req, err = http.NewRequestWithContext(ctx, http.MethodGet, url, nil); err != nil {
+		return Reading{}, err
+	}
+
+	var resp *http.Response
+	if resp, err = o.doer.Do(req); err != nil {
+		return Reading{}, err
+	}
+	defer resp.Body.Close() // Ignore this error, if any.
+
+	var respJSON []byte
+	if respJSON, err = ioutil.ReadAll(resp.Body); err != nil {
+		return Reading{}, err
+	}
+
+	temp := gjson.GetBytes(respJSON, "current.temperature_2m")
+	if !temp.Exists() {
+		return Reading{}, ErrNoTemperature
+	}
+
+	return Reading{
+		Temperature:            temp.Float(),
+		FeelsLike:              gjson.GetBytes(respJSON, "current.apparent_temperature").Float(),
+		Humidity:               gjson.GetBytes(respJSON, "current.relative_humidity_2m").Float(),
+		WindSpeed:              gjson.GetBytes(respJSON, "current.wind_speed_10m").Float(),
+		WindBearing:            gjson.GetBytes(respJSON, "current.wind_direction_10m").Float(),
+		PrecipitationIntensity: gjson.GetBytes(respJSON, "current.precipitation").Float(),
+		Pressure:               gjson.GetBytes(respJSON, "current.surface_pressure").Float(),
+		CloudCover:             gjson.GetBytes(respJSON, "current.cloud_cover").Float(),
+		ConditionCode:          gjson.GetBytes(respJSON, "current.weather_code").Int(),
+		Time:                   time.Now(),
+	}, nil
+}
+
+// Forecast implements the WeatherProvider interface.
+func (o *OpenMeteo) Forecast(ctx context.Context, lat, lon float64, days int) (readings []Reading, err error) {
+	url := fmt.Sprintf(openMeteoDailyURLTemplate, lat, lon, days)
+
+	var req *http.Request
+	if req, err = http.NewRequestWithContext(ctx, http.MethodGet, url, nil); err != nil {
+		return nil, err
+	}
+
+	var resp *http.Response
+	if resp, err = o.doer.Do(req); err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close() // Ignore this error, if any.
+
+	var respJSON []byte
+	if respJSON, err = ioutil.ReadAll(resp.Body); err != nil {
+		return nil, err
+	}
+
+	temps := gjson.GetBytes(respJSON, "daily.temperature_2m_max").Array()
+	if len(temps) == 0 {
+		return nil, ErrNoTemperature
+	}
+
+	times := gjson.GetBytes(respJSON, "daily.time").Array()
+	feelsLikes := gjson.GetBytes(respJSON, "daily.apparent_temperature_max").Array()
+	humidities := gjson.GetBytes(respJSON, "daily.relative_humidity_2m_mean").Array()
+	windSpeeds := gjson.GetBytes(respJSON, "daily.wind_speed_10m_max").Array()
+	windBearings := gjson.GetBytes(respJSON, "daily.wind_direction_10m_dominant").Array()
+	precipProbabilities := gjson.GetBytes(respJSON, "daily.precipitation_probability_mean").Array()
+	precipSums := gjson.GetBytes(respJSON, "daily.precipitation_sum").Array()
+	pressures := gjson.GetBytes(respJSON, "daily.surface_pressure_mean").Array()
+	cloudCovers := gjson.GetBytes(respJSON, "daily.cloud_cover_mean").Array()
+	conditionCodes := gjson.GetBytes(respJSON, "daily.weather_code").Array()
+
+	readings = make([]Reading, len(temps))
+	for i := range temps {
+		day := time.Now().AddDate(0, 0, i)
+		if i < len(times) {
+			if parsed, parseErr := time.Parse("2006-01-02", times[i].String()); parseErr == nil {
+				day = parsed
+			}
+		}
+		readings[i] = Reading{
+			Temperature:              temps[i].Float(),
+			FeelsLike:                arrayFloat(feelsLikes, i),
+			Humidity:                 arrayFloat(humidities, i),
+			WindSpeed:                arrayFloat(windSpeeds, i),
+			WindBearing:              arrayFloat(windBearings, i),
+			PrecipitationProbability: arrayFloat(precipProbabilities, i),
+			PrecipitationIntensity:   arrayFloat(precipSums, i),
+			Pressure:                 arrayFloat(pressures, i),
+			CloudCover:               arrayFloat(cloudCovers, i),
+			ConditionCode:            arrayInt(conditionCodes, i),
+			Time:                     day,
+		}
+	}
+
+	return readings, nil
+}
+
+// arrayFloat returns the float64 at index i of values, or 0 if i is out of range.
+func arrayFloat(values []gjson.Result, i int) float64 {
+	if i >= len(values) {
+		return 0
+	}
+	return values[i].Float()
+}
+
+// arrayInt returns the int64 at index i of values, or 0 if i is out of range.
+func arrayInt(values []gjson.Result, i int) int64 {
+	if i >= len(values) {
+		return 0
+	}
+	return values[i].Int()
+}
@@ -0,0 +1,108 @@
+package providers
+
+import (
+	"context"
+	"testing"
+)
+
+const openMeteoCurrentSampleResponse = `{
+	"current": {
+		"temperature_2m": 68.4,
+		"apparent_temperature": 66.9,
+		"relative_humidity_2m": 55,
+		"wind_speed_10m": 6.2,
+		"wind_direction_10m": 180,
+		"precipitation": 0,
+		"surface_pressure": 1015,
+		"cloud_cover": 20,
+		"weather_code": 1
+	}
+}`
+
+func TestOpenMeteoCurrentReading(t *testing.T) {
+	o := NewOpenMeteo(stubDoer{body: openMeteoCurrentSampleResponse})
+
+	reading, err := o.CurrentReading(context.Background(), 41.8, -87.6)
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err)
+	}
+
+	if reading.Temperature != 68.4 {
+		t.Errorf("expected temperature 68.4, got %f", reading.Temperature)
+	}
+	if reading.FeelsLike != 66.9 {
+		t.Errorf("expected feels-like 66.9, got %f", reading.FeelsLike)
+	}
+	if reading.Humidity != 55 {
+		t.Errorf("expected humidity 55, got %f", reading.Humidity)
+	}
+	if reading.WindSpeed != 6.2 {
+		t.Errorf("expected wind speed 6.2, got %f", reading.WindSpeed)
+	}
+	if reading.WindBearing != 180 {
+		t.Errorf("expected wind bearing 180, got %f", reading.WindBearing)
+	}
+	if reading.Pressure != 1015 {
+		t.Errorf("expected pressure 1015, got %f", reading.Pressure)
+	}
+	if reading.CloudCover != 20 {
+		t.Errorf("expected cloud cover 20, got %f", reading.CloudCover)
+	}
+	if reading.ConditionCode != 1 {
+		t.Errorf("expected condition code 1, got %d", reading.ConditionCode)
+	}
+}
+
+func TestOpenMeteoCurrentReadingNoTemperature(t *testing.T) {
+	o := NewOpenMeteo(stubDoer{body: `{}`})
+
+	if _, err := o.CurrentReading(context.Background(), 41.8, -87.6); err != ErrNoTemperature {
+		t.Fatalf("expected ErrNoTemperature, got: %v", err)
+	}
+}
+
+const openMeteoDailySampleResponse = `{
+	"daily": {
+		"time": ["2026-07-26", "2026-07-27"],
+		"temperature_2m_max": [75.1, 77.3],
+		"apparent_temperature_max": [74.0, 76.0],
+		"relative_humidity_2m_mean": [40, 42],
+		"wind_speed_10m_max": [10.5, 11.2],
+		"wind_direction_10m_dominant": [200, 210],
+		"precipitation_probability_mean": [10, 20],
+		"precipitation_sum": [0, 0.2],
+		"surface_pressure_mean": [1010, 1009],
+		"cloud_cover_mean": [30, 35],
+		"weather_code": [2, 3]
+	}
+}`
+
+func TestOpenMeteoForecast(t *testing.T) {
+	o := NewOpenMeteo(stubDoer{body: openMeteoDailySampleResponse})
+
+	readings, err := o.Forecast(context.Background(), 41.8, -87.6, 2)
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err)
+	}
+	if len(readings) != 2 {
+		t.Fatalf("expected 2 readings, got %d", len(readings))
+	}
+
+	if readings[0].Temperature != 75.1 || readings[1].Temperature != 77.3 {
+		t.Errorf("unexpected temperatures: %+v", readings)
+	}
+	if readings[0].PrecipitationProbability != 10 || readings[1].PrecipitationProbability != 20 {
+		t.Errorf("unexpected precipitation probabilities: %+v", readings)
+	}
+	if readings[0].ConditionCode != 2 || readings[1].ConditionCode != 3 {
+		t.Errorf("unexpected condition codes: %+v", readings)
+	}
+}
+
+func TestOpenMeteoForecastNoTemperature(t *testing.T) {
+	o := NewOpenMeteo(stubDoer{body: `{"daily": {}}`})
+
+	if _, err := o.Forecast(context.Background(), 41.8, -87.6, 2); err != ErrNoTemperature {
+		t.Fatalf("expected ErrNoTemperature, got: %v", err)
+	}
+}
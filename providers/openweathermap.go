@@ -0,0 +1,107 @@
+package providers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/tidwall/gjson"
+
+	"github.com/MicahParks/temperature-challenge/httputil"
+)
+
+// openWeatherMapCurrentURLTemplate is the URL template for OpenWeatherMap's current weather endpoint.
+const openWeatherMapCurrentURLTemplate = "https://api.openweathermap.org/data/2.5/weather?lat=%f&lon=%f&appid=%s&units=imperial"
+
+// openWeatherMapFreeTierCallsPerMinute is the rate limit of OpenWeatherMap's free "Current Weather Data" plan, used
+// to configure the default doer so this tool doesn't get throttled mid-run.
+const openWeatherMapFreeTierCallsPerMinute = 60
+
+// ErrNoAPIKey indicates an OpenWeatherMap provider was created without an API key.
+var ErrNoAPIKey = errors.New("an OpenWeatherMap API key is required")
+
+// OpenWeatherMap is a WeatherProvider backed by the OpenWeatherMap API.
+//
+// An API key is required. One can be obtained for free at https://openweathermap.org/api.
+type OpenWeatherMap struct {
+	apiKey string
+	doer   httputil.Doer
+}
+
+// NewOpenWeatherMap creates a new OpenWeatherMap provider that authenticates with the given API key. If doer is nil,
+// requests go through a httputil.RetryDoer rate limited to the free tier's calls-per-minute allowance.
+func NewOpenWeatherMap(apiKey string, doer httputil.Doer) (*OpenWeatherMap, error) {
+	if apiKey == "" {
+		return nil, ErrNoAPIKey
+	}
+	if doer == nil {
+		doer = httputil.NewRetryDoer(http.DefaultClient, openWeatherMapFreeTierCallsPerMinute, 5, 3)
+	}
+	return &OpenWeatherMap{
+		apiKey: apiKey,
+		doer:   doer,
+	}, nil
+}
+
+// CurrentReading implements the WeatherProvider interface.
+func (o *OpenWeatherMap) CurrentReading(ctx context.Context, lat, lon float64) (reading Reading, err error) {
+	url := fmt.Sprintf(openWeatherMapCurrentURLTemplate, lat, lon, o.apiKey)
+
+	var req *http.Request
+	if req, err = http.NewRequestWithContext(ctx, http.MethodGet, url, nil); err != nil {
+		return Reading{}, err
+	}
+
+	var resp *http.Response
+	if resp, err = o.doer.Do(req); err != nil {
+		return Reading{}, err
+	}
+	defer resp.Body.Close() // Ignore this error, if any.
+
+	var respJSON []byte
+	if respJSON, err = ioutil.ReadAll(resp.Body); err != nil {
+		return Reading{}, err
+	}
+
+	temp := gjson.GetBytes(respJSON, "main.temp")
+	if !temp.Exists() {
+		return Reading{}, ErrNoTemperature
+	}
+
+	return Reading{
+		Temperature:            temp.Float(),
+		FeelsLike:              gjson.GetBytes(respJSON, "main.feels_like").Float(),
+		Humidity:               gjson.GetBytes(respJSON, "main.humidity").Float(),
+		WindSpeed:              gjson.GetBytes(respJSON, "wind.speed").Float(),
+		WindBearing:            gjson.GetBytes(respJSON, "wind.deg").Float(),
+		PrecipitationIntensity: gjson.GetBytes(respJSON, "rain.1h").Float(),
+		Pressure:               gjson.GetBytes(respJSON, "main.pressure").Float(),
+		CloudCover:             gjson.GetBytes(respJSON, "clouds.all").Float(),
+		ConditionCode:          gjson.GetBytes(respJSON, "weather.0.id").Int(),
+		Time:                   time.Now(),
+	}, nil
+}
+
+// Forecast implements the WeatherProvider interface.
+//
+// OpenWeatherMap's free tier only exposes a current conditions endpoint, so this calls CurrentReading once and
+// repeats it for each requested day rather than hitting a paid forecast endpoint.
+func (o *OpenWeatherMap) Forecast(ctx context.Context, lat, lon float64, days int) (readings []Reading, err error) {
+	var current Reading
+	if current, err = o.CurrentReading(ctx, lat, lon); err != nil {
+		return nil, err
+	}
+
+	readings = make([]Reading, days)
+	now := time.Now()
+	for i := 0; i < days; i++ {
+		reading := current
+		reading.Time = now.AddDate(0, 0, i)
+		readings[i] = reading
+	}
+
+	return readings, nil
+}
@@ -0,0 +1,108 @@
+package providers
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+// stubDoer returns a canned response for every request, so provider field-mapping logic can be tested without a
+// real network call.
+type stubDoer struct {
+	body string
+}
+
+func (s stubDoer) Do(_ *http.Request) (*http.Response, error) {
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Body:       io.NopCloser(strings.NewReader(s.body)),
+		Header:     make(http.Header),
+	}, nil
+}
+
+const openWeatherMapSampleResponse = `{
+	"main": {"temp": 72.5, "feels_like": 70.1, "humidity": 50, "pressure": 1012},
+	"wind": {"speed": 8.5, "deg": 270},
+	"clouds": {"all": 40},
+	"rain": {"1h": 0.1},
+	"weather": [{"id": 800}]
+}`
+
+func TestOpenWeatherMapCurrentReading(t *testing.T) {
+	o, err := NewOpenWeatherMap("test-key", stubDoer{body: openWeatherMapSampleResponse})
+	if err != nil {
+		t.Fatalf("failed to create provider: %s", err)
+	}
+
+	reading, err := o.CurrentReading(context.Background(), 41.8, -87.6)
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err)
+	}
+
+	if reading.Temperature != 72.5 {
+		t.Errorf("expected temperature 72.5, got %f", reading.Temperature)
+	}
+	if reading.FeelsLike != 70.1 {
+		t.Errorf("expected feels-like 70.1, got %f", reading.FeelsLike)
+	}
+	if reading.Humidity != 50 {
+		t.Errorf("expected humidity 50, got %f", reading.Humidity)
+	}
+	if reading.WindSpeed != 8.5 {
+		t.Errorf("expected wind speed 8.5, got %f", reading.WindSpeed)
+	}
+	if reading.WindBearing != 270 {
+		t.Errorf("expected wind bearing 270, got %f", reading.WindBearing)
+	}
+	if reading.PrecipitationIntensity != 0.1 {
+		t.Errorf("expected precipitation intensity 0.1, got %f", reading.PrecipitationIntensity)
+	}
+	if reading.Pressure != 1012 {
+		t.Errorf("expected pressure 1012, got %f", reading.Pressure)
+	}
+	if reading.CloudCover != 40 {
+		t.Errorf("expected cloud cover 40, got %f", reading.CloudCover)
+	}
+	if reading.ConditionCode != 800 {
+		t.Errorf("expected condition code 800, got %d", reading.ConditionCode)
+	}
+}
+
+func TestOpenWeatherMapCurrentReadingNoTemperature(t *testing.T) {
+	o, err := NewOpenWeatherMap("test-key", stubDoer{body: `{}`})
+	if err != nil {
+		t.Fatalf("failed to create provider: %s", err)
+	}
+
+	if _, err = o.CurrentReading(context.Background(), 41.8, -87.6); err != ErrNoTemperature {
+		t.Fatalf("expected ErrNoTemperature, got: %v", err)
+	}
+}
+
+func TestNewOpenWeatherMapRequiresAPIKey(t *testing.T) {
+	if _, err := NewOpenWeatherMap("", nil); err != ErrNoAPIKey {
+		t.Fatalf("expected ErrNoAPIKey, got: %v", err)
+	}
+}
+
+func TestOpenWeatherMapForecastRepeatsCurrentReading(t *testing.T) {
+	o, err := NewOpenWeatherMap("test-key", stubDoer{body: openWeatherMapSampleResponse})
+	if err != nil {
+		t.Fatalf("failed to create provider: %s", err)
+	}
+
+	readings, err := o.Forecast(context.Background(), 41.8, -87.6, 3)
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err)
+	}
+	if len(readings) != 3 {
+		t.Fatalf("expected 3 readings, got %d", len(readings))
+	}
+	for _, reading := range readings {
+		if reading.Temperature != 72.5 {
+			t.Errorf("expected temperature 72.5, got %f", reading.Temperature)
+		}
+	}
+}
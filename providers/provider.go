@@ -0,0 +1,68 @@
+// Package providers defines a pluggable abstraction over weather data sources.
+//
+// MetaWeather, the original data source for this tool, has been shut down for some time. Rather than hardwire
+// another single vendor in its place, this package exposes a WeatherProvider interface so the tool can fall back to
+// a different backend without a rewrite the next time a vendor disappears.
+package providers
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// ErrNoTemperature indicates a provider's response did not include a current temperature reading.
+var ErrNoTemperature = errors.New("a temperature reading was not returned by the provider")
+
+// Reading is a single weather observation for a set of coordinates, modeled after the fields OpenWeatherMap's
+// Main/Wind/Clouds/Rain objects and Open-Meteo's hourly variables both expose.
+type Reading struct {
+
+	// Temperature is the temperature in Fahrenheit.
+	Temperature float64
+
+	// FeelsLike is the apparent temperature in Fahrenheit, accounting for wind and humidity.
+	FeelsLike float64
+
+	// Humidity is the relative humidity, as a percentage.
+	Humidity float64
+
+	// WindSpeed is the wind speed in miles per hour.
+	WindSpeed float64
+
+	// WindBearing is the direction the wind is blowing from, in degrees clockwise from north.
+	WindBearing float64
+
+	// PrecipitationProbability is the chance of precipitation, as a percentage. Not every provider can supply this
+	// for a current-conditions reading; it's left at 0 when unavailable.
+	PrecipitationProbability float64
+
+	// PrecipitationIntensity is the precipitation volume in millimeters.
+	PrecipitationIntensity float64
+
+	// Pressure is the atmospheric pressure, in hPa. OpenWeatherMap reports it at sea level; Open-Meteo reports it at
+	// the surface.
+	Pressure float64
+
+	// CloudCover is the cloudiness, as a percentage.
+	CloudCover float64
+
+	// ConditionCode is the provider's own weather condition code (e.g. OpenWeatherMap's or Open-Meteo's WMO code),
+	// passed through as-is rather than normalized, since providers don't share a single code space.
+	ConditionCode int64
+
+	// Time is when the reading was taken or forecast for.
+	Time time.Time
+}
+
+// WeatherProvider is implemented by anything that can turn a pair of coordinates into weather data. It is the
+// seam implementations like OpenWeatherMap and Open-Meteo plug into so the rest of the tool does not need to know
+// which backend is in use.
+type WeatherProvider interface {
+
+	// CurrentReading returns the current Reading at the given coordinates.
+	CurrentReading(ctx context.Context, lat, lon float64) (Reading, error)
+
+	// Forecast returns a Reading per day for the given number of days, starting today, at the given coordinates.
+	Forecast(ctx context.Context, lat, lon float64, days int) ([]Reading, error)
+}
@@ -0,0 +1,197 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+
+	// reportPathEnv overrides where the aggregate weather report is written. An empty value (the default) disables
+	// report writing entirely, since most deployments only care about the Prometheus metrics.
+	reportPathEnv = "REPORT_PATH"
+
+	// reportFormatEnv overrides the report's format. Supported values are "json" and "csv".
+	reportFormatEnv = "REPORT_FORMAT"
+
+	// defaultReportFormat is used when reportFormatEnv isn't set.
+	defaultReportFormat = "json"
+)
+
+// cityReport is a single city's reading, as written to the report.
+type cityReport struct {
+	City                     string    `json:"city"`
+	Latitude                 float64   `json:"latitude"`
+	Longitude                float64   `json:"longitude"`
+	Temperature              float64   `json:"temperature"`
+	FeelsLike                float64   `json:"feelsLike"`
+	Humidity                 float64   `json:"humidity"`
+	WindSpeed                float64   `json:"windSpeed"`
+	WindBearing              float64   `json:"windBearing"`
+	PrecipitationProbability float64   `json:"precipitationProbability"`
+	PrecipitationIntensity   float64   `json:"precipitationIntensity"`
+	Pressure                 float64   `json:"pressure"`
+	CloudCover               float64   `json:"cloudCover"`
+	ConditionCode            int64     `json:"conditionCode"`
+	Time                     time.Time `json:"time"`
+}
+
+// aggregateReport summarizes every city's reading and the fleet-wide average of each numeric weather field. The
+// average's city, coordinates, condition code, and time are left at their zero values.
+type aggregateReport struct {
+	GeneratedAt time.Time    `json:"generatedAt"`
+	Cities      []cityReport `json:"cities"`
+	Average     cityReport   `json:"average"`
+}
+
+// writeReport aggregates results across the whole city set and writes it to reportPathEnv in reportFormatEnv, if
+// reportPathEnv is set. It's a no-op otherwise.
+func writeReport(results []fetchResult) error {
+	path := os.Getenv(reportPathEnv)
+	if path == "" {
+		return nil
+	}
+
+	report := buildAggregateReport(results)
+
+	switch reportFormat() {
+	case "csv":
+		return writeReportCSV(path, report)
+	default:
+		return writeReportJSON(path, report)
+	}
+}
+
+// buildAggregateReport builds an aggregateReport from every successful fetchResult, skipping the rest.
+func buildAggregateReport(results []fetchResult) aggregateReport {
+	report := aggregateReport{GeneratedAt: time.Now()}
+
+	var count int
+	for _, result := range results {
+		if result.err != nil {
+			continue
+		}
+		reading := result.reading
+		report.Cities = append(report.Cities, cityReport{
+			City:                     result.coord.Name,
+			Latitude:                 result.coord.Latitude,
+			Longitude:                result.coord.Longitude,
+			Temperature:              reading.Temperature,
+			FeelsLike:                reading.FeelsLike,
+			Humidity:                 reading.Humidity,
+			WindSpeed:                reading.WindSpeed,
+			WindBearing:              reading.WindBearing,
+			PrecipitationProbability: reading.PrecipitationProbability,
+			PrecipitationIntensity:   reading.PrecipitationIntensity,
+			Pressure:                 reading.Pressure,
+			CloudCover:               reading.CloudCover,
+			ConditionCode:            reading.ConditionCode,
+			Time:                     reading.Time,
+		})
+
+		report.Average.Temperature += reading.Temperature
+		report.Average.FeelsLike += reading.FeelsLike
+		report.Average.Humidity += reading.Humidity
+		report.Average.WindSpeed += reading.WindSpeed
+		report.Average.WindBearing += reading.WindBearing
+		report.Average.PrecipitationProbability += reading.PrecipitationProbability
+		report.Average.PrecipitationIntensity += reading.PrecipitationIntensity
+		report.Average.Pressure += reading.Pressure
+		report.Average.CloudCover += reading.CloudCover
+		count++
+	}
+
+	if count > 0 {
+		report.Average.Temperature /= float64(count)
+		report.Average.FeelsLike /= float64(count)
+		report.Average.Humidity /= float64(count)
+		report.Average.WindSpeed /= float64(count)
+		report.Average.WindBearing /= float64(count)
+		report.Average.PrecipitationProbability /= float64(count)
+		report.Average.PrecipitationIntensity /= float64(count)
+		report.Average.Pressure /= float64(count)
+		report.Average.CloudCover /= float64(count)
+	}
+
+	return report
+}
+
+// writeReportJSON writes report to path as pretty-printed JSON.
+func writeReportJSON(path string, report aggregateReport) error {
+	b, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, b, 0o644)
+}
+
+// writeReportCSV writes report to path as CSV, one row per city plus a trailing "average" row.
+func writeReportCSV(path string, report aggregateReport) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close() // Ignore this error, if any.
+
+	w := csv.NewWriter(f)
+	defer w.Flush()
+
+	header := []string{
+		"city", "latitude", "longitude", "temperature", "feelsLike", "humidity", "windSpeed", "windBearing",
+		"precipitationProbability", "precipitationIntensity", "pressure", "cloudCover", "conditionCode", "time",
+	}
+	if err = w.Write(header); err != nil {
+		return err
+	}
+
+	for _, city := range report.Cities {
+		if err = w.Write(cityReportRow(city)); err != nil {
+			return err
+		}
+	}
+
+	average := report.Average
+	average.City = "average"
+	if err = w.Write(cityReportRow(average)); err != nil {
+		return err
+	}
+
+	w.Flush()
+	return w.Error()
+}
+
+// cityReportRow renders a cityReport as a CSV row.
+func cityReportRow(city cityReport) []string {
+	return []string{
+		city.City,
+		strconv.FormatFloat(city.Latitude, 'f', -1, 64),
+		strconv.FormatFloat(city.Longitude, 'f', -1, 64),
+		strconv.FormatFloat(city.Temperature, 'f', -1, 64),
+		strconv.FormatFloat(city.FeelsLike, 'f', -1, 64),
+		strconv.FormatFloat(city.Humidity, 'f', -1, 64),
+		strconv.FormatFloat(city.WindSpeed, 'f', -1, 64),
+		strconv.FormatFloat(city.WindBearing, 'f', -1, 64),
+		strconv.FormatFloat(city.PrecipitationProbability, 'f', -1, 64),
+		strconv.FormatFloat(city.PrecipitationIntensity, 'f', -1, 64),
+		strconv.FormatFloat(city.Pressure, 'f', -1, 64),
+		strconv.FormatFloat(city.CloudCover, 'f', -1, 64),
+		strconv.FormatInt(city.ConditionCode, 10),
+		city.Time.Format(time.RFC3339),
+	}
+}
+
+// reportFormat returns the configured report format, read from REPORT_FORMAT if set and valid.
+func reportFormat() string {
+	switch strings.ToLower(os.Getenv(reportFormatEnv)) {
+	case "csv":
+		return "csv"
+	case "json":
+		return "json"
+	default:
+		return defaultReportFormat
+	}
+}